@@ -0,0 +1,475 @@
+/*
+Copyright 2025 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer generates KubeVela component definition parameters from
+// existing Kubernetes workload manifests. It mirrors Podman's GenerateForKube
+// in reverse: given a *v1.Pod, *appsv1.Deployment, or *appsv1.StatefulSet, it
+// walks each container and reconstructs the typed parameter structs
+// (components.Env, components.Port, components.VolumeMounts, ...) that a
+// hand-written component definition would have populated, so a user can go
+// from a running workload (or hand-written YAML) straight to a reusable
+// component without re-typing its configuration.
+package importer
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/anoop2811/vela-definitions/components"
+)
+
+// ContainerParams is the populated set of typed parameters for a single
+// container, in the shape consumed by ContainerMountsHelper, PodVolumesHelper,
+// ContainerPortsTransform and friends.
+type ContainerParams struct {
+	// Name is the container name the parameters were imported from.
+	Name string
+	// Image is the container image.
+	Image string
+	// Env holds the container's environment variables.
+	Env []components.Env
+	// Ports holds the container's exposed ports.
+	Ports []components.Port
+	// VolumeMounts holds the volumes mounted into the container.
+	VolumeMounts components.VolumeMounts
+	// LivenessProbe is the container's liveness probe, if set.
+	LivenessProbe *components.HealthProbe
+	// ReadinessProbe is the container's readiness probe, if set.
+	ReadinessProbe *components.HealthProbe
+	// Resources holds the container's resource limits.
+	Resources components.ResourceLimit
+}
+
+// PodParams is the populated set of typed parameters for a whole pod
+// template: one ContainerParams per container, plus pod-level settings.
+type PodParams struct {
+	// Containers holds the per-container parameters, in spec order.
+	Containers []ContainerParams
+	// HostAliases holds the pod's host alias entries.
+	HostAliases []components.HostAlias
+	// ImagePullSecrets holds the names of the pod's image pull secrets.
+	ImagePullSecrets []string
+}
+
+// FromPod builds PodParams from a Pod manifest.
+func FromPod(pod *corev1.Pod) (*PodParams, error) {
+	if pod == nil {
+		return nil, fmt.Errorf("pod is nil")
+	}
+	return fromPodSpec(&pod.Spec)
+}
+
+// FromDeployment builds PodParams from a Deployment's pod template.
+func FromDeployment(deploy *appsv1.Deployment) (*PodParams, error) {
+	if deploy == nil {
+		return nil, fmt.Errorf("deployment is nil")
+	}
+	return fromPodSpec(&deploy.Spec.Template.Spec)
+}
+
+// FromStatefulSet builds PodParams from a StatefulSet's pod template.
+func FromStatefulSet(sts *appsv1.StatefulSet) (*PodParams, error) {
+	if sts == nil {
+		return nil, fmt.Errorf("statefulset is nil")
+	}
+	return fromPodSpec(&sts.Spec.Template.Spec)
+}
+
+// fromPodSpec walks a PodSpec and reconstructs its typed parameters.
+func fromPodSpec(spec *corev1.PodSpec) (*PodParams, error) {
+	params := &PodParams{
+		HostAliases:      importHostAliases(spec.HostAliases),
+		ImagePullSecrets: importImagePullSecrets(spec.ImagePullSecrets),
+	}
+
+	for _, c := range spec.Containers {
+		cp, err := importContainer(spec, c)
+		if err != nil {
+			return nil, fmt.Errorf("importing container %q: %w", c.Name, err)
+		}
+		params.Containers = append(params.Containers, *cp)
+	}
+	return params, nil
+}
+
+// importContainer reconstructs a single container's typed parameters,
+// resolving its volumeMounts against the pod-level volumes they reference.
+func importContainer(spec *corev1.PodSpec, c corev1.Container) (*ContainerParams, error) {
+	volumeMounts, err := importVolumeMounts(spec.Volumes, c.VolumeMounts)
+	if err != nil {
+		return nil, err
+	}
+
+	livenessProbe, err := importProbe(c.LivenessProbe, c.Ports)
+	if err != nil {
+		return nil, fmt.Errorf("livenessProbe: %w", err)
+	}
+	readinessProbe, err := importProbe(c.ReadinessProbe, c.Ports)
+	if err != nil {
+		return nil, fmt.Errorf("readinessProbe: %w", err)
+	}
+
+	return &ContainerParams{
+		Name:           c.Name,
+		Image:          c.Image,
+		Env:            importEnv(c.Env),
+		Ports:          importPorts(c.Ports),
+		VolumeMounts:   volumeMounts,
+		LivenessProbe:  livenessProbe,
+		ReadinessProbe: readinessProbe,
+		Resources:      importResources(c.Resources),
+	}, nil
+}
+
+// importEnv reverses a container's env entries.
+func importEnv(env []corev1.EnvVar) []components.Env {
+	var out []components.Env
+	for _, e := range env {
+		ce := components.Env{Name: e.Name}
+		switch {
+		case e.ValueFrom == nil:
+			ce.Value = components.StringPtr(e.Value)
+		case e.ValueFrom.SecretKeyRef != nil:
+			ce.ValueFrom = &components.EnvValueFrom{
+				SecretKeyRef: &components.KeyRef{
+					Name: e.ValueFrom.SecretKeyRef.Name,
+					Key:  e.ValueFrom.SecretKeyRef.Key,
+				},
+			}
+		case e.ValueFrom.ConfigMapKeyRef != nil:
+			ce.ValueFrom = &components.EnvValueFrom{
+				ConfigMapKeyRef: &components.KeyRef{
+					Name: e.ValueFrom.ConfigMapKeyRef.Name,
+					Key:  e.ValueFrom.ConfigMapKeyRef.Key,
+				},
+			}
+		}
+		out = append(out, ce)
+	}
+	return out
+}
+
+// importPorts reverses a container's containerPort entries.
+func importPorts(ports []corev1.ContainerPort) []components.Port {
+	var out []components.Port
+	for _, p := range ports {
+		cp := components.Port{
+			Port:     int(p.ContainerPort),
+			Protocol: string(p.Protocol),
+		}
+		if p.Name != "" {
+			cp.Name = components.StringPtr(p.Name)
+		}
+		out = append(out, cp)
+	}
+	return out
+}
+
+// importProbe reverses a liveness/readiness probe, resolving any named port
+// reference against the container's declared ports.
+func importProbe(p *corev1.Probe, containerPorts []corev1.ContainerPort) (*components.HealthProbe, error) {
+	if p == nil {
+		return nil, nil
+	}
+	hp := &components.HealthProbe{
+		InitialDelaySeconds: int(p.InitialDelaySeconds),
+		PeriodSeconds:       int(p.PeriodSeconds),
+		TimeoutSeconds:      int(p.TimeoutSeconds),
+		SuccessThreshold:    int(p.SuccessThreshold),
+		FailureThreshold:    int(p.FailureThreshold),
+	}
+	switch {
+	case p.Exec != nil:
+		hp.Exec = &components.ExecProbe{Command: p.Exec.Command}
+	case p.HTTPGet != nil:
+		httpGet, err := importHTTPGetProbe(p.HTTPGet, containerPorts)
+		if err != nil {
+			return nil, err
+		}
+		hp.HTTPGet = httpGet
+	case p.TCPSocket != nil:
+		port, err := resolveProbePort(p.TCPSocket.Port, containerPorts)
+		if err != nil {
+			return nil, err
+		}
+		hp.TCPSocket = &components.TCPSocketProbe{Port: port}
+	}
+	return hp, nil
+}
+
+// resolveProbePort resolves a probe's port, which Kubernetes allows to
+// reference a container's declared named port instead of a number, to its
+// numeric value.
+func resolveProbePort(port intstr.IntOrString, containerPorts []corev1.ContainerPort) (int, error) {
+	if port.Type == intstr.Int {
+		return port.IntValue(), nil
+	}
+	for _, p := range containerPorts {
+		if p.Name == port.StrVal {
+			return int(p.ContainerPort), nil
+		}
+	}
+	return 0, fmt.Errorf("probe references named port %q which is not declared on the container", port.StrVal)
+}
+
+// importHTTPGetProbe reverses an HTTPGet probe action.
+func importHTTPGetProbe(a *corev1.HTTPGetAction, containerPorts []corev1.ContainerPort) (*components.HTTPGetProbe, error) {
+	port, err := resolveProbePort(a.Port, containerPorts)
+	if err != nil {
+		return nil, err
+	}
+	hg := &components.HTTPGetProbe{
+		Path:   a.Path,
+		Port:   port,
+		Scheme: string(a.Scheme),
+	}
+	if a.Host != "" {
+		hg.Host = components.StringPtr(a.Host)
+	}
+	for _, h := range a.HTTPHeaders {
+		hg.HTTPHeaders = append(hg.HTTPHeaders, components.HTTPHeader{Name: h.Name, Value: h.Value})
+	}
+	return hg, nil
+}
+
+// importResources reverses a container's resource requirements into the
+// simplified cpu/memory limit pair this package's parameters expose.
+func importResources(r corev1.ResourceRequirements) components.ResourceLimit {
+	var rl components.ResourceLimit
+	if cpu, ok := r.Limits[corev1.ResourceCPU]; ok {
+		rl.CPU = components.StringPtr(cpu.String())
+	}
+	if mem, ok := r.Limits[corev1.ResourceMemory]; ok {
+		rl.Memory = components.StringPtr(mem.String())
+	}
+	return rl
+}
+
+// importHostAliases reverses the pod's hostAliases.
+func importHostAliases(aliases []corev1.HostAlias) []components.HostAlias {
+	var out []components.HostAlias
+	for _, a := range aliases {
+		out = append(out, components.HostAlias{IP: a.IP, Hostnames: a.Hostnames})
+	}
+	return out
+}
+
+// importImagePullSecrets reverses the pod's imagePullSecrets into a plain
+// name list, matching the shape ImagePullSecretsTransform expects as input.
+func importImagePullSecrets(refs []corev1.LocalObjectReference) []string {
+	var out []string
+	for _, r := range refs {
+		out = append(out, r.Name)
+	}
+	return out
+}
+
+// importVolumeMounts reverses the pod's volumes and the container's
+// volumeMounts into a single components.VolumeMounts, matching each
+// container volumeMount to its pod volume by name.
+func importVolumeMounts(volumes []corev1.Volume, mounts []corev1.VolumeMount) (components.VolumeMounts, error) {
+	byName := make(map[string]corev1.Volume, len(volumes))
+	for _, v := range volumes {
+		byName[v.Name] = v
+	}
+
+	var out components.VolumeMounts
+	for _, m := range mounts {
+		v, ok := byName[m.Name]
+		if !ok {
+			return out, fmt.Errorf("volumeMount %q has no matching pod volume", m.Name)
+		}
+
+		var subPath *string
+		if m.SubPath != "" {
+			subPath = components.StringPtr(m.SubPath)
+		}
+
+		switch {
+		case v.PersistentVolumeClaim != nil:
+			out.PVC = append(out.PVC, components.PVCMount{
+				Name:      m.Name,
+				MountPath: m.MountPath,
+				SubPath:   subPath,
+				ClaimName: v.PersistentVolumeClaim.ClaimName,
+			})
+		case v.ConfigMap != nil:
+			out.ConfigMap = append(out.ConfigMap, components.ConfigMapMount{
+				Name:        m.Name,
+				MountPath:   m.MountPath,
+				SubPath:     subPath,
+				DefaultMode: importVolumeDefaultMode(v.ConfigMap.DefaultMode),
+				CMName:      v.ConfigMap.Name,
+				Items:       importVolumeItems(v.ConfigMap.Items),
+			})
+		case v.Secret != nil:
+			out.Secret = append(out.Secret, components.SecretMount{
+				Name:        m.Name,
+				MountPath:   m.MountPath,
+				SubPath:     subPath,
+				DefaultMode: importVolumeDefaultMode(v.Secret.DefaultMode),
+				SecretName:  v.Secret.SecretName,
+				Items:       importVolumeItems(v.Secret.Items),
+			})
+		case v.EmptyDir != nil:
+			out.EmptyDir = append(out.EmptyDir, components.EmptyDirMount{
+				Name:      m.Name,
+				MountPath: m.MountPath,
+				SubPath:   subPath,
+				Medium:    string(v.EmptyDir.Medium),
+			})
+		case v.HostPath != nil:
+			out.HostPath = append(out.HostPath, components.HostPathMount{
+				Name:      m.Name,
+				MountPath: m.MountPath,
+				SubPath:   subPath,
+				Path:      v.HostPath.Path,
+			})
+		case v.Projected != nil:
+			out.Projected = append(out.Projected, components.ProjectedMount{
+				Name:        m.Name,
+				MountPath:   m.MountPath,
+				SubPath:     subPath,
+				DefaultMode: importVolumeDefaultMode(v.Projected.DefaultMode),
+				Sources:     importProjectedSources(v.Projected.Sources),
+			})
+		case v.CSI != nil:
+			csi := components.CSIMount{
+				Name:             m.Name,
+				MountPath:        m.MountPath,
+				SubPath:          subPath,
+				Driver:           v.CSI.Driver,
+				ReadOnly:         v.CSI.ReadOnly,
+				VolumeAttributes: v.CSI.VolumeAttributes,
+			}
+			if v.CSI.NodePublishSecretRef != nil {
+				csi.NodePublishSecretRef = &components.LocalObjectReference{Name: v.CSI.NodePublishSecretRef.Name}
+			}
+			out.CSI = append(out.CSI, csi)
+		case v.DownwardAPI != nil:
+			out.DownwardAPI = append(out.DownwardAPI, components.DownwardAPIMount{
+				Name:        m.Name,
+				MountPath:   m.MountPath,
+				SubPath:     subPath,
+				DefaultMode: importVolumeDefaultMode(v.DownwardAPI.DefaultMode),
+				Items:       importDownwardAPIItems(v.DownwardAPI.Items),
+			})
+		default:
+			return out, fmt.Errorf("volume %q uses an unsupported source type", m.Name)
+		}
+	}
+	return out, nil
+}
+
+// importVolumeItems reverses a ConfigMap/Secret volume's key-to-path items.
+func importVolumeItems(items []corev1.KeyToPath) []components.VolumeItem {
+	var out []components.VolumeItem
+	for _, i := range items {
+		out = append(out, components.VolumeItem{Key: i.Key, Path: i.Path, Mode: importItemMode(i.Mode)})
+	}
+	return out
+}
+
+// importDownwardAPIItems reverses a DownwardAPI volume or projection's items.
+func importDownwardAPIItems(items []corev1.DownwardAPIVolumeFile) []components.DownwardAPIItem {
+	var out []components.DownwardAPIItem
+	for _, i := range items {
+		item := components.DownwardAPIItem{Path: i.Path}
+		if i.Mode != nil {
+			item.Mode = components.IntPtr(int(*i.Mode))
+		}
+		if i.FieldRef != nil {
+			item.FieldRef = &components.ObjectFieldSelector{FieldPath: i.FieldRef.FieldPath}
+		}
+		if i.ResourceFieldRef != nil {
+			rfr := &components.ResourceFieldSelector{Resource: i.ResourceFieldRef.Resource}
+			if i.ResourceFieldRef.ContainerName != "" {
+				rfr.ContainerName = components.StringPtr(i.ResourceFieldRef.ContainerName)
+			}
+			if divisor := i.ResourceFieldRef.Divisor.String(); divisor != "" {
+				rfr.Divisor = components.StringPtr(divisor)
+			}
+			item.ResourceFieldRef = rfr
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// importProjectedSources reverses a Projected volume's list of sources.
+func importProjectedSources(sources []corev1.VolumeProjection) []components.ProjectedSource {
+	var out []components.ProjectedSource
+	for _, s := range sources {
+		var ps components.ProjectedSource
+		switch {
+		case s.Secret != nil:
+			ps.Secret = &components.ProjectedSecretSource{
+				Name:     s.Secret.Name,
+				Items:    importVolumeItems(s.Secret.Items),
+				Optional: s.Secret.Optional,
+			}
+		case s.ConfigMap != nil:
+			ps.ConfigMap = &components.ProjectedConfigMapSource{
+				Name:     s.ConfigMap.Name,
+				Items:    importVolumeItems(s.ConfigMap.Items),
+				Optional: s.ConfigMap.Optional,
+			}
+		case s.ServiceAccountToken != nil:
+			sat := &components.ServiceAccountTokenProjection{Path: s.ServiceAccountToken.Path}
+			if s.ServiceAccountToken.Audience != "" {
+				sat.Audience = components.StringPtr(s.ServiceAccountToken.Audience)
+			}
+			if s.ServiceAccountToken.ExpirationSeconds != nil {
+				sat.ExpirationSeconds = components.IntPtr(int(*s.ServiceAccountToken.ExpirationSeconds))
+			}
+			ps.ServiceAccountToken = sat
+		case s.DownwardAPI != nil:
+			ps.DownwardAPI = &components.DownwardAPIProjection{Items: importDownwardAPIItems(s.DownwardAPI.Items)}
+		}
+		out = append(out, ps)
+	}
+	return out
+}
+
+// defaultVolumeFileMode is Kubernetes' own default for ConfigMap, Secret,
+// Projected, and DownwardAPI volumes' defaultMode (see e.g.
+// corev1.ConfigMapVolumeSourceDefaultMode) when the manifest doesn't set one.
+const defaultVolumeFileMode = 0644
+
+// importVolumeDefaultMode converts a volume's optional defaultMode pointer to
+// the plain int this package's volume mount structs use, defaulting to
+// Kubernetes' own default (0644) when unset, so an un-set defaultMode isn't
+// silently downgraded to unreadable (mode 0) files.
+func importVolumeDefaultMode(mode *int32) int {
+	if mode == nil {
+		return defaultVolumeFileMode
+	}
+	return int(*mode)
+}
+
+// importItemMode converts a volume item's optional file-mode pointer to the
+// plain int this package's volume mount structs use. Unlike a volume's
+// defaultMode, an unset item mode means "inherit the volume's defaultMode",
+// so it defaults to 0 here rather than 0644.
+func importItemMode(mode *int32) int {
+	if mode == nil {
+		return 0
+	}
+	return int(*mode)
+}
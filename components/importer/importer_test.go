@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestFromPodResolvesNamedProbePort(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "web",
+					Ports: []corev1.ContainerPort{
+						{Name: "http", ContainerPort: 8080},
+					},
+					ReadinessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{
+								Path: "/healthz",
+								Port: intstr.FromString("http"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	params, err := FromPod(pod)
+	if err != nil {
+		t.Fatalf("FromPod returned error: %v", err)
+	}
+	if len(params.Containers) != 1 {
+		t.Fatalf("expected 1 container, got %d", len(params.Containers))
+	}
+
+	probe := params.Containers[0].ReadinessProbe
+	if probe == nil || probe.HTTPGet == nil {
+		t.Fatalf("expected an httpGet readiness probe, got %+v", probe)
+	}
+	if probe.HTTPGet.Port != 8080 {
+		t.Errorf("expected named port %q to resolve to 8080, got %d", "http", probe.HTTPGet.Port)
+	}
+}
+
+func TestFromPodRejectsUnresolvableNamedProbePort(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "web",
+					LivenessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							TCPSocket: &corev1.TCPSocketAction{
+								Port: intstr.FromString("missing"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if _, err := FromPod(pod); err == nil {
+		t.Fatal("expected FromPod to reject a probe referencing an undeclared named port")
+	}
+}
+
+func TestFromPodMatchesVolumeMountsByName(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-pvc"},
+					},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "web",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "data", MountPath: "/var/data", SubPath: "sub"},
+					},
+				},
+			},
+		},
+	}
+
+	params, err := FromPod(pod)
+	if err != nil {
+		t.Fatalf("FromPod returned error: %v", err)
+	}
+
+	mounts := params.Containers[0].VolumeMounts
+	if len(mounts.PVC) != 1 {
+		t.Fatalf("expected 1 PVC mount, got %d", len(mounts.PVC))
+	}
+	pvc := mounts.PVC[0]
+	if pvc.ClaimName != "data-pvc" || pvc.MountPath != "/var/data" || pvc.SubPath == nil || *pvc.SubPath != "sub" {
+		t.Errorf("unexpected PVC mount: %+v", pvc)
+	}
+}
+
+func TestFromPodRejectsUnmatchedVolumeMount(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:         "web",
+					VolumeMounts: []corev1.VolumeMount{{Name: "missing", MountPath: "/var/data"}},
+				},
+			},
+		},
+	}
+
+	if _, err := FromPod(pod); err == nil {
+		t.Fatal("expected FromPod to reject a volumeMount with no matching pod volume")
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"fmt"
+
+	"github.com/oam-dev/kubevela/pkg/definition/defkit"
+
+	"github.com/anoop2811/vela-definitions/components"
+)
+
+// ToComponentDefinition converts PodParams into the defkit.Value parameter
+// set consumed by ContainerMountsHelper, PodVolumesHelper,
+// ContainerPortsTransform and friends, and renders it into a
+// ComponentDefinition the same way a hand-written component definition
+// would. This is the other half of the import: FromPod/FromDeployment/
+// FromStatefulSet read a manifest into typed structs; ToComponentDefinition
+// turns those structs back into a reusable component.
+//
+// Only the first container is rendered as the component's primary workload
+// container, matching the single-container shape KubeVela's built-in
+// webservice/worker definitions assume; additional containers are left for
+// the caller to attach as sidecars once the primary definition exists.
+func ToComponentDefinition(name string, pod *PodParams) (*defkit.ComponentDefinition, error) {
+	if pod == nil || len(pod.Containers) == 0 {
+		return nil, fmt.Errorf("pod has no containers to render")
+	}
+	primary := pod.Containers[0]
+
+	def := defkit.NewComponentDefinition(name)
+	tpl := def.Template()
+
+	image := defkit.Literal(primary.Image)
+	env := defkit.Literal(primary.Env)
+	ports := defkit.Literal(primary.Ports)
+	volumeMounts := defkit.Literal(primary.VolumeMounts)
+	imagePullSecrets := defkit.Literal(pod.ImagePullSecrets)
+	hostAliases := defkit.Literal(pod.HostAliases)
+
+	deployment := tpl.Object("deployment")
+	deployment.Set("spec.template.spec.containers[0].name", defkit.Literal(primary.Name))
+	deployment.Set("spec.template.spec.containers[0].image", image)
+	deployment.SetIf(env.IsSet(), "spec.template.spec.containers[0].env",
+		components.ContainerEnvTransform(env))
+	deployment.SetIf(ports.IsSet(), "spec.template.spec.containers[0].ports",
+		components.ContainerPortsTransform(ports))
+	deployment.SetIf(volumeMounts.IsSet(), "spec.template.spec.containers[0].volumeMounts",
+		components.ContainerMountsHelper(tpl, volumeMounts))
+	deployment.SetIf(volumeMounts.IsSet(), "spec.template.spec.volumes",
+		components.PodVolumesHelper(tpl, volumeMounts))
+	deployment.SetIf(imagePullSecrets.IsSet(), "spec.template.spec.imagePullSecrets",
+		components.ImagePullSecretsTransform(imagePullSecrets))
+	deployment.SetIf(hostAliases.IsSet(), "spec.template.spec.hostAliases", hostAliases)
+
+	// The importer only reconstructs container resource limits (see
+	// importResources), so the request-side arguments are always unset;
+	// ResourceRequirementsTransform still takes care of omitting cpu/memory
+	// (and the whole limits object) independently when unset, rather than
+	// emitting them as null.
+	noRequest := defkit.Literal((*string)(nil))
+	cpuLimit := defkit.Literal(primary.Resources.CPU)
+	memoryLimit := defkit.Literal(primary.Resources.Memory)
+	resources := components.ResourceRequirementsTransform(noRequest, noRequest, cpuLimit, memoryLimit)
+	deployment.SetIf(cpuLimit.IsSet().Or(memoryLimit.IsSet()),
+		"spec.template.spec.containers[0].resources", resources)
+
+	return def, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/oam-dev/kubevela/pkg/definition/defkit"
+)
+
+// NormalizeQuantity validates s against the Kubernetes resource-quantity
+// grammar (via resource.ParseQuantity) and returns the canonical decimal-SI
+// string form of its value, so that two equivalent quantities written with
+// different suffixes (e.g. "1024Mi" and "1Gi", or "1Gi" and "1073741824")
+// normalize to the same string and can be compared for equality. Parsing and
+// comparison are delegated to
+// k8s.io/apimachinery/pkg/api/resource rather than hand-rolled arithmetic,
+// since quantities at the Peta/Exa end of the grammar exceed what float64 can
+// represent exactly.
+func NormalizeQuantity(s string) (string, error) {
+	q, err := resource.ParseQuantity(strings.TrimSpace(s))
+	if err != nil {
+		return "", fmt.Errorf("invalid resource quantity %q: %w", s, err)
+	}
+	// q.String() keeps the format the input was written in, so re-express the
+	// value in decimal-SI to give binary-SI, decimal-SI and exponent forms of
+	// the same amount one shared string.
+	return resource.NewDecimalQuantity(*q.AsDec(), resource.DecimalSI).String(), nil
+}
+
+// QuantityString returns a string parameter validated against the Kubernetes
+// resource-quantity grammar, rejecting malformed values such as "500mi"
+// (should be "500m") or "1Gb" (should be "1Gi") at definition-render time
+// with an error pointing at the parameter path.
+func QuantityString(name string) defkit.Param {
+	return defkit.String(name).Validate(func(v string) error {
+		if _, err := NormalizeQuantity(v); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import "testing"
+
+func TestNormalizeQuantity(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{name: "binary-SI suffix", in: "1Gi"},
+		{name: "decimal-SI suffix", in: "500m"},
+		{name: "no suffix", in: "2"},
+		{name: "decimal value", in: "0.5"},
+		{name: "invalid lowercase binary suffix", in: "500mi", wantErr: true},
+		{name: "invalid decimal suffix", in: "1Gb", wantErr: true},
+		{name: "not a number", in: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NormalizeQuantity(tt.in)
+			if tt.wantErr && err == nil {
+				t.Fatalf("NormalizeQuantity(%q) succeeded, want error", tt.in)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("NormalizeQuantity(%q) returned error: %v", tt.in, err)
+			}
+		})
+	}
+}
+
+// TestNormalizeQuantityEquivalentFormsMatch guards the equality guarantee
+// NormalizeQuantity documents: two differently-suffixed but equal quantities
+// must normalize to the same string.
+func TestNormalizeQuantityEquivalentFormsMatch(t *testing.T) {
+	equivalents := [][2]string{
+		{"1024Mi", "1Gi"},
+		{"1000m", "1"},
+		{"1000000000", "1G"},
+	}
+	for _, pair := range equivalents {
+		a, err := NormalizeQuantity(pair[0])
+		if err != nil {
+			t.Fatalf("NormalizeQuantity(%q): %v", pair[0], err)
+		}
+		b, err := NormalizeQuantity(pair[1])
+		if err != nil {
+			t.Fatalf("NormalizeQuantity(%q): %v", pair[1], err)
+		}
+		if a != b {
+			t.Errorf("expected %q and %q to normalize equal, got %q vs %q", pair[0], pair[1], a, b)
+		}
+	}
+}
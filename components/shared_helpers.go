@@ -27,11 +27,11 @@ import (
 // --- Volume Mount Helpers ---
 
 // volumeMountSources are the standard volume mount source fields.
-var volumeMountSources = []string{"pvc", "configMap", "secret", "emptyDir", "hostPath"}
+var volumeMountSources = []string{"pvc", "configMap", "secret", "emptyDir", "hostPath", "projected", "csi", "downwardAPI"}
 
 // ContainerMountsHelper creates a helper for container volumeMounts.
-// Transforms volumeMounts from multiple sources (pvc, configMap, secret, emptyDir, hostPath)
-// into the container volumeMounts format: [{name, mountPath, subPath?}]
+// Transforms volumeMounts from multiple sources (pvc, configMap, secret, emptyDir, hostPath,
+// projected, csi, downwardAPI) into the container volumeMounts format: [{name, mountPath, subPath?}]
 //
 // Usage:
 //
@@ -72,6 +72,9 @@ func ContainerMountsDedupedHelper(tpl *defkit.Template, volumeMounts defkit.Valu
 //   - secret -> secret
 //   - emptyDir -> emptyDir
 //   - hostPath -> hostPath
+//   - projected -> projected
+//   - csi -> csi
+//   - downwardAPI -> downwardAPI
 //
 // Usage:
 //
@@ -129,6 +132,29 @@ func podVolumeMappings() map[string]defkit.FieldMap {
 			"name":     defkit.FieldRef("name"),
 			"hostPath": defkit.Nested(defkit.FieldMap{"path": defkit.FieldRef("path")}),
 		},
+		"projected": {
+			"name": defkit.FieldRef("name"),
+			"projected": defkit.Nested(defkit.FieldMap{
+				"defaultMode": defkit.FieldRef("defaultMode"),
+				"sources":     defkit.Optional("sources"),
+			}),
+		},
+		"csi": {
+			"name": defkit.FieldRef("name"),
+			"csi": defkit.Nested(defkit.FieldMap{
+				"driver":               defkit.FieldRef("driver"),
+				"readOnly":             defkit.Optional("readOnly"),
+				"volumeAttributes":     defkit.Optional("volumeAttributes"),
+				"nodePublishSecretRef": defkit.Optional("nodePublishSecretRef"),
+			}),
+		},
+		"downwardAPI": {
+			"name": defkit.FieldRef("name"),
+			"downwardAPI": defkit.Nested(defkit.FieldMap{
+				"defaultMode": defkit.FieldRef("defaultMode"),
+				"items":       defkit.Optional("items"),
+			}),
+		},
 	}
 }
 
@@ -145,6 +171,53 @@ func ImagePullSecretsTransform(imagePullSecrets defkit.Value) *defkit.Collection
 	return defkit.Each(imagePullSecrets).Wrap("name")
 }
 
+// --- Env Helpers ---
+
+// ContainerEnvTransform transforms env definitions to container env format.
+// Maps: {name, value} -> {name, value} and {name, valueFrom} -> {name, valueFrom: {secretKeyRef|configMapKeyRef}}
+//
+// Usage:
+//
+//	containerEnv := ContainerEnvTransform(env)
+//	deployment.SetIf(env.IsSet(), "spec...env", containerEnv)
+func ContainerEnvTransform(envs defkit.Value) *defkit.CollectionOp {
+	return defkit.Each(envs).Map(defkit.FieldMap{
+		"name":  defkit.FieldRef("name"),
+		"value": defkit.PickIf(defkit.ItemFieldIsSet("value"), defkit.FieldRef("value")),
+		"valueFrom": defkit.PickIf(defkit.ItemFieldIsSet("valueFrom"), defkit.Nested(defkit.FieldMap{
+			"secretKeyRef": defkit.PickIf(defkit.ItemFieldIsSet("valueFrom.secretKeyRef"), defkit.Nested(defkit.FieldMap{
+				"name": defkit.FieldRef("valueFrom.secretKeyRef.name"),
+				"key":  defkit.FieldRef("valueFrom.secretKeyRef.key"),
+			})),
+			"configMapKeyRef": defkit.PickIf(defkit.ItemFieldIsSet("valueFrom.configMapKeyRef"), defkit.Nested(defkit.FieldMap{
+				"name": defkit.FieldRef("valueFrom.configMapKeyRef.name"),
+				"key":  defkit.FieldRef("valueFrom.configMapKeyRef.key"),
+			})),
+		})),
+	})
+}
+
+// ContainerEnvFromTransform transforms envFrom definitions to container envFrom format.
+// Maps: {configMapRef|secretRef: {name, optional?}, prefix?} -> {configMapRef|secretRef: {name, optional?}, prefix?}
+//
+// Usage:
+//
+//	containerEnvFrom := ContainerEnvFromTransform(envFrom)
+//	deployment.SetIf(envFrom.IsSet(), "spec...envFrom", containerEnvFrom)
+func ContainerEnvFromTransform(envFrom defkit.Value) *defkit.CollectionOp {
+	return defkit.Each(envFrom).Map(defkit.FieldMap{
+		"prefix": defkit.PickIf(defkit.ItemFieldIsSet("prefix"), defkit.FieldRef("prefix")),
+		"configMapRef": defkit.PickIf(defkit.ItemFieldIsSet("configMapRef"), defkit.Nested(defkit.FieldMap{
+			"name":     defkit.FieldRef("configMapRef.name"),
+			"optional": defkit.PickIf(defkit.ItemFieldIsSet("configMapRef.optional"), defkit.FieldRef("configMapRef.optional")),
+		})),
+		"secretRef": defkit.PickIf(defkit.ItemFieldIsSet("secretRef"), defkit.Nested(defkit.FieldMap{
+			"name":     defkit.FieldRef("secretRef.name"),
+			"optional": defkit.PickIf(defkit.ItemFieldIsSet("secretRef.optional"), defkit.FieldRef("secretRef.optional")),
+		})),
+	})
+}
+
 // --- Port Helpers ---
 
 // ContainerPortsTransform transforms port definitions to container port format.
@@ -180,6 +253,61 @@ func ServicePortsTransform(ports defkit.Value) *defkit.CollectionOp {
 	})
 }
 
+// --- Service Helpers ---
+
+// maxSessionAffinityTimeoutSeconds is the Kubernetes maximum for
+// spec.sessionAffinityConfig.clientIP.timeoutSeconds. Users who want sticky
+// sessions without worrying about a short default expiry can request the
+// "Permanent" session affinity mode to get this value automatically.
+const maxSessionAffinityTimeoutSeconds = 86400
+
+// ServiceSpecHelper creates a helper for a Service's spec: it filters ports
+// down to those marked for exposure, maps them to the Service port format
+// (including nodePort when serviceType is "NodePort"), and sets spec.type
+// and session affinity.
+//
+// sessionAffinity accepts "None", "ClientIP", or "Permanent". "Permanent" is
+// emitted as ClientIP affinity with the timeout clamped to Kubernetes' max
+// (86400s), for users who want sticky sessions without a short expiry;
+// "ClientIP" uses sessionAffinityTimeout as-is. sessionAffinityConfig is only
+// emitted for "ClientIP"/"Permanent" (and only once a timeout is known for
+// plain "ClientIP"), since Kubernetes rejects sessionAffinityConfig being set
+// while sessionAffinity is "None", and rejects a timeoutSeconds of 0.
+//
+// Usage:
+//
+//	serviceSpec := ServiceSpecHelper(tpl, ports, serviceType, sessionAffinity, sessionAffinityTimeout)
+//	service.Set("spec", serviceSpec)
+func ServiceSpecHelper(tpl *defkit.Template, ports defkit.Value, serviceType defkit.Value, sessionAffinity defkit.Value, sessionAffinityTimeout defkit.Value) *defkit.HelperVar {
+	exposedPorts := tpl.Helper("exposedServicePorts").
+		FromFields(ports).
+		Filter(defkit.ItemFieldEquals("expose", true)).
+		Map(defkit.FieldMap{
+			"port":       defkit.FieldRef("port").Or(defkit.FieldRef("containerPort")),
+			"targetPort": defkit.FieldRef("port").Or(defkit.FieldRef("containerPort")),
+			"name":       defkit.FieldRef("name").Or(defkit.Format("port-%v", defkit.FieldRef("port").Or(defkit.FieldRef("containerPort")))),
+			"protocol":   defkit.FieldRef("protocol"),
+			"nodePort": defkit.PickIf(
+				defkit.ValueEquals(serviceType, "NodePort").And(defkit.ItemFieldIsSet("nodePort")),
+				defkit.FieldRef("nodePort"),
+			),
+		}).
+		Build()
+
+	isPermanentAffinity := defkit.ValueEquals(sessionAffinity, "Permanent")
+	isClientIPAffinity := defkit.ValueEquals(sessionAffinity, "ClientIP")
+	usesClientIPConfig := isPermanentAffinity.Or(isClientIPAffinity)
+
+	return tpl.Helper("serviceSpec").
+		Set("ports", exposedPorts).
+		Set("type", serviceType).
+		SetIf(sessionAffinity.IsSet(), "sessionAffinity",
+			defkit.IfElse(isPermanentAffinity, defkit.Literal("ClientIP"), sessionAffinity)).
+		SetIf(usesClientIPConfig.And(isPermanentAffinity.Or(sessionAffinityTimeout.IsSet())), "sessionAffinityConfig.clientIP.timeoutSeconds",
+			defkit.IfElse(isPermanentAffinity, defkit.Literal(maxSessionAffinityTimeoutSeconds), sessionAffinityTimeout)).
+		Build()
+}
+
 // --- Common Parameter Definitions ---
 
 // CommonVolumeParams returns the standard volumeMounts parameter definition.
@@ -187,6 +315,16 @@ func CommonVolumeParams() defkit.Param {
 	return defkit.Object("volumeMounts").Description("Volume mount configurations")
 }
 
+// CommonEnvParams returns the standard env parameter definition.
+func CommonEnvParams() defkit.Param {
+	return defkit.Object("env").Description("Define arguments by using environment variables")
+}
+
+// CommonEnvFromParams returns the standard envFrom parameter definition.
+func CommonEnvFromParams() defkit.Param {
+	return defkit.Object("envFrom").Description("Define arguments to be loaded from a ConfigMap or Secret as a group of environment variables")
+}
+
 // CommonImagePullSecretsParam returns the standard imagePullSecrets parameter.
 func CommonImagePullSecretsParam() defkit.Param {
 	return defkit.StringList("imagePullSecrets").Description("Specify image pull secrets for your service")
@@ -201,11 +339,60 @@ func CommonProbeParams() (livenessProbe, readinessProbe defkit.Param) {
 	return
 }
 
-// CommonResourceParams returns cpu and memory parameters.
+// CommonServiceParams returns the standard exposeType, sessionAffinity, and
+// sessionAffinityTimeout parameters.
+func CommonServiceParams() (exposeType, sessionAffinity, sessionAffinityTimeout defkit.Param) {
+	exposeType = defkit.String("exposeType").
+		Description("Specify what kind of Service you want. Options: \"ClusterIP\", \"NodePort\", \"LoadBalancer\"").
+		Default("ClusterIP")
+	sessionAffinity = defkit.String("sessionAffinity").
+		Description("Specify the Service session affinity. Options: \"None\", \"ClientIP\", \"Permanent\" (ClientIP affinity pinned to the maximum Kubernetes timeout)").
+		Default("None")
+	sessionAffinityTimeout = defkit.Int("sessionAffinityTimeout").
+		Description("Specify the session sticky time, only works when sessionAffinity is \"ClientIP\"")
+	return
+}
+
+// CommonResourceParams returns cpu and memory parameters. Both are validated
+// against the Kubernetes resource-quantity grammar at definition-render time.
 func CommonResourceParams() (cpu, memory defkit.Param) {
-	cpu = defkit.String("cpu").
+	cpu = QuantityString("cpu").
 		Description("Number of CPU units for the service, like `0.5` (0.5 CPU core), `1` (1 CPU core)")
-	memory = defkit.String("memory").
+	memory = QuantityString("memory").
 		Description("Specifies the attributes of the memory resource required for the container.")
 	return
 }
+
+// CommonResourceLimitParams returns cpuLimit and memoryLimit parameters for
+// use alongside CommonResourceParams' request-side cpu/memory. Both are
+// validated against the Kubernetes resource-quantity grammar at
+// definition-render time.
+func CommonResourceLimitParams() (cpuLimit, memoryLimit defkit.Param) {
+	cpuLimit = QuantityString("cpuLimit").
+		Description("Limit of CPU units for the service, like `0.5` (0.5 CPU core), `1` (1 CPU core)")
+	memoryLimit = QuantityString("memoryLimit").
+		Description("Specifies the limit of the memory resource for the container.")
+	return
+}
+
+// ResourceRequirementsTransform transforms cpu/memory request and limit
+// values into the container resources format: {requests: {cpu?, memory?},
+// limits: {cpu?, memory?}}. Each of requests/limits, and each of cpu/memory
+// within them, is only emitted when its value is set.
+//
+// Usage:
+//
+//	resources := ResourceRequirementsTransform(cpu, memory, cpuLimit, memoryLimit)
+//	deployment.Set("spec...resources", resources)
+func ResourceRequirementsTransform(cpu, memory, cpuLimit, memoryLimit defkit.Value) *defkit.CollectionOp {
+	return defkit.Nested(defkit.FieldMap{
+		"requests": defkit.PickIf(cpu.IsSet().Or(memory.IsSet()), defkit.Nested(defkit.FieldMap{
+			"cpu":    defkit.PickIf(cpu.IsSet(), cpu),
+			"memory": defkit.PickIf(memory.IsSet(), memory),
+		})),
+		"limits": defkit.PickIf(cpuLimit.IsSet().Or(memoryLimit.IsSet()), defkit.Nested(defkit.FieldMap{
+			"cpu":    defkit.PickIf(cpuLimit.IsSet(), cpuLimit),
+			"memory": defkit.PickIf(memoryLimit.IsSet(), memoryLimit),
+		})),
+	})
+}
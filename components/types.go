@@ -43,6 +43,33 @@ type KeyRef struct {
 	Key string
 }
 
+// EnvFrom loads all key-value pairs of a ConfigMap or Secret as environment
+// variables. Exactly one of ConfigMapRef or SecretRef should be set.
+type EnvFrom struct {
+	// ConfigMapRef loads all entries of a ConfigMap as environment variables.
+	ConfigMapRef *EnvFromConfigMapRef
+	// SecretRef loads all entries of a Secret as environment variables.
+	SecretRef *EnvFromSecretRef
+	// Prefix is prepended to each environment variable name.
+	Prefix *string
+}
+
+// EnvFromConfigMapRef references a ConfigMap to load as environment variables.
+type EnvFromConfigMapRef struct {
+	// Name is the name of the ConfigMap.
+	Name string
+	// Optional marks the ConfigMap as not required to exist.
+	Optional *bool
+}
+
+// EnvFromSecretRef references a Secret to load as environment variables.
+type EnvFromSecretRef struct {
+	// Name is the name of the Secret.
+	Name string
+	// Optional marks the Secret as not required to exist.
+	Optional *bool
+}
+
 // Port represents a port configuration.
 type Port struct {
 	// Port is the port number to expose on the pod's IP address.
@@ -71,6 +98,12 @@ type VolumeMounts struct {
 	EmptyDir []EmptyDirMount
 	// HostPath mounts HostPath volumes.
 	HostPath []HostPathMount
+	// Projected mounts Projected volumes, combining several sources into one mount.
+	Projected []ProjectedMount
+	// CSI mounts ephemeral CSI volumes.
+	CSI []CSIMount
+	// DownwardAPI mounts DownwardAPI volumes.
+	DownwardAPI []DownwardAPIMount
 }
 
 // PVCMount represents a PVC volume mount.
@@ -124,6 +157,101 @@ type VolumeItem struct {
 	Mode int
 }
 
+// ProjectedMount represents a Projected volume mount, combining one or more
+// sources (secret, configMap, serviceAccountToken, downwardAPI) into a single mount.
+type ProjectedMount struct {
+	Name        string
+	MountPath   string
+	SubPath     *string
+	DefaultMode int
+	Sources     []ProjectedSource
+}
+
+// ProjectedSource represents a single source within a Projected volume.
+// Exactly one of the fields should be set.
+type ProjectedSource struct {
+	// Secret projects a Secret into the volume.
+	Secret *ProjectedSecretSource
+	// ConfigMap projects a ConfigMap into the volume.
+	ConfigMap *ProjectedConfigMapSource
+	// ServiceAccountToken projects a service account token into the volume.
+	ServiceAccountToken *ServiceAccountTokenProjection
+	// DownwardAPI projects pod/container fields into the volume.
+	DownwardAPI *DownwardAPIProjection
+}
+
+// ProjectedSecretSource projects a Secret's keys into a Projected volume.
+type ProjectedSecretSource struct {
+	Name     string
+	Items    []VolumeItem
+	Optional *bool
+}
+
+// ProjectedConfigMapSource projects a ConfigMap's keys into a Projected volume.
+type ProjectedConfigMapSource struct {
+	Name     string
+	Items    []VolumeItem
+	Optional *bool
+}
+
+// ServiceAccountTokenProjection projects a service account token into a Projected volume.
+type ServiceAccountTokenProjection struct {
+	Audience          *string
+	ExpirationSeconds *int
+	Path              string
+}
+
+// DownwardAPIProjection projects pod/container fields into a Projected volume.
+type DownwardAPIProjection struct {
+	Items []DownwardAPIItem
+}
+
+// DownwardAPIItem represents a single file exposed by a DownwardAPI volume or projection.
+type DownwardAPIItem struct {
+	Path             string
+	FieldRef         *ObjectFieldSelector
+	ResourceFieldRef *ResourceFieldSelector
+	Mode             *int
+}
+
+// ObjectFieldSelector selects a field of the pod to expose as a DownwardAPI file.
+type ObjectFieldSelector struct {
+	FieldPath string
+}
+
+// ResourceFieldSelector selects a container resource (e.g. a CPU/memory limit) to
+// expose as a DownwardAPI file.
+type ResourceFieldSelector struct {
+	ContainerName *string
+	Resource      string
+	Divisor       *string
+}
+
+// CSIMount represents an ephemeral CSI volume mount.
+type CSIMount struct {
+	Name                 string
+	MountPath            string
+	SubPath              *string
+	Driver               string
+	ReadOnly             *bool
+	VolumeAttributes     map[string]string
+	NodePublishSecretRef *LocalObjectReference
+}
+
+// LocalObjectReference references an object in the same namespace.
+type LocalObjectReference struct {
+	Name string
+}
+
+// DownwardAPIMount represents a DownwardAPI volume mount.
+type DownwardAPIMount struct {
+	Name        string
+	MountPath   string
+	SubPath     *string
+	DefaultMode int
+	Items       []DownwardAPIItem
+}
+
 // HealthProbe represents container health probe configuration.
 type HealthProbe struct {
 	// Exec specifies a command-based health check.